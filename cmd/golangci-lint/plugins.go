@@ -1,6 +1,9 @@
 package testsnake
 
 import (
+	"fmt"
+
+	"github.com/go-viper/mapstructure/v2"
 	"github.com/golangci/plugin-module-register/register"
 	"golang.org/x/tools/go/analysis"
 )
@@ -10,19 +13,25 @@ func init() {
 }
 
 func New(settings any) (register.LinterPlugin, error) {
-	// The configuration type will be map[string]any or []interface, it depends on your configuration.
-	// You can use https://github.com/go-viper/mapstructure to convert map to struct.
-
-	return &plugin{}, nil
+	cfg := defaultConfig()
+	if settings != nil {
+		if err := mapstructure.Decode(settings, &cfg); err != nil {
+			return nil, fmt.Errorf("testsnake: decode settings: %w", err)
+		}
+	}
+
+	return &plugin{cfg: cfg}, nil
 }
 
-type plugin struct{}
+type plugin struct {
+	cfg Config
+}
 
 var _ register.LinterPlugin = new(plugin)
 
-func (*plugin) BuildAnalyzers() ([]*analysis.Analyzer, error) {
+func (p *plugin) BuildAnalyzers() ([]*analysis.Analyzer, error) {
 	return []*analysis.Analyzer{
-		Analyzer,
+		NewAnalyzer(p.cfg),
 	}, nil
 }
 
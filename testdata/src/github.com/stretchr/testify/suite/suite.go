@@ -0,0 +1,28 @@
+// Package suite is a minimal stand-in for testify/suite, just enough for
+// testsnake's testdata to exercise real suite.Run(t, ts) detection without
+// depending on the actual module.
+package suite
+
+import "testing"
+
+// Suite is embedded by test suites run via Run.
+type Suite struct {
+	t *testing.T
+}
+
+// T returns the suite's current *testing.T.
+func (s *Suite) T() *testing.T { return s.t }
+
+// SetT sets the suite's current *testing.T.
+func (s *Suite) SetT(t *testing.T) { s.t = t }
+
+// TestingSuite is the interface Run accepts.
+type TestingSuite interface {
+	T() *testing.T
+	SetT(t *testing.T)
+}
+
+// Run sets t on ts; unlike testify's Run, it does not call ts's TestXxx methods.
+func Run(t *testing.T, ts TestingSuite) {
+	ts.SetT(t)
+}
@@ -76,7 +76,7 @@ func TestExample(t *testing.T) {
 	})
 
 	invalid_concat_name := "invalid" + "Snake"
-	t.Run(invalid_name, func(t *testing.T) { // want "test name \"invalidSnake\" should use snake_case"
+	t.Run(invalid_concat_name, func(t *testing.T) { // want "test name \"invalidSnake\" should use snake_case"
 		// This should fail
 	})
 }
@@ -107,3 +107,143 @@ func TestParallel(t *testing.T) {
 		})
 	}
 }
+
+func TestMapDriven(t *testing.T) {
+	tests := map[string]struct {
+		want string
+	}{
+		"valid_snake_case": {want: "foobar"},
+		"InvalidMapCase": { // want "test name \"InvalidMapCase\" should use snake_case"
+			want: "foobar",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_ = tc.want
+		})
+	}
+}
+
+func TestPositionalElements(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"valid_positional_name", "foobar"},
+		{"InvalidPositionalName", "foobar"}, // want "test name \"InvalidPositionalName\" should use snake_case"
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+		})
+	}
+}
+
+func TestPointerElements(t *testing.T) {
+	tests := []*struct {
+		name string
+		want string
+	}{
+		{name: "valid_pointer_name", want: "foobar"},
+		{name: "InvalidPointerName", want: "foobar"}, // want "test name \"InvalidPointerName\" should use snake_case"
+		&struct {
+			name string
+			want string
+		}{name: "InvalidExplicitPointerName", want: "foobar"}, // want "test name \"InvalidExplicitPointerName\" should use snake_case"
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+		})
+	}
+}
+
+func TestPointerPositionalElements(t *testing.T) {
+	tests := []*struct {
+		name string
+		want string
+	}{
+		{"valid_pointer_positional_name", "foobar"},
+		{"InvalidPointerPositionalName", "foobar"}, // want "test name \"InvalidPointerPositionalName\" should use snake_case"
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+		})
+	}
+}
+
+func caseTable() []struct{ name string } {
+	return []struct{ name string }{
+		{name: "valid_from_helper"},
+		{name: "InvalidFromHelper"}, // want "test name \"InvalidFromHelper\" should use snake_case"
+	}
+}
+
+func TestFunctionReturnedTable(t *testing.T) {
+	tests := caseTable()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+		})
+	}
+}
+
+// runWithCustomRunner ranges over cases and calls Run on a Runner, not a
+// testing handle: this is NOT the subtests-helper pattern and its table
+// must not be flagged.
+func runWithCustomRunner(cases []struct{ name string }) {
+	r := &Runner{}
+	for _, tc := range cases {
+		r.Run(tc.name, func() {
+		})
+	}
+}
+
+func TestCustomRunnerHelper(t *testing.T) {
+	cases := []struct{ name string }{
+		{name: "NotATestName"},
+	}
+
+	runWithCustomRunner(cases)
+}
+
+func runCases(t *testing.T, cases []struct{ name string }) {
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+		})
+	}
+}
+
+func TestSubtestsHelper(t *testing.T) {
+	cases := []struct{ name string }{
+		{name: "valid_from_subtests_helper"},
+		{name: "InvalidFromSubtestsHelper"}, // want "test name \"InvalidFromSubtestsHelper\" should use snake_case"
+	}
+
+	runCases(t, cases)
+}
+
+// aliasedT is a `type = testing.T` alias, not a distinct named type.
+type aliasedT = testing.T
+
+func helperWithAliasedReceiver(t *aliasedT) {
+	t.Run("InvalidAliasedReceiverName", func(t *testing.T) { // want "test name \"InvalidAliasedReceiverName\" should use snake_case"
+	})
+}
+
+// embeddedT embeds *testing.T, so its promoted Run method should still be linted.
+type embeddedT struct {
+	*testing.T
+}
+
+func (e *embeddedT) helperRun() {
+	e.Run("InvalidEmbeddedReceiverName", func(t *testing.T) { // want "test name \"InvalidEmbeddedReceiverName\" should use snake_case"
+	})
+}
+
+func TestTestingTypeVariants(t *testing.T) {
+	helperWithAliasedReceiver(t)
+	(&embeddedT{T: t}).helperRun()
+}
@@ -0,0 +1,15 @@
+package testlintdata
+
+import "testing"
+
+// Config for this package sets Allow: []string{"^legacy_.*$"}.
+
+func TestAllowlist(t *testing.T) {
+	t.Run("legacy_CamelHoldover", func(t *testing.T) {
+		// Good: exempted by the "^legacy_.*$" allow pattern
+	})
+
+	t.Run("NotExempt", func(t *testing.T) { // want "test name \"NotExempt\" should use snake_case"
+		// Bad: doesn't match the allow pattern
+	})
+}
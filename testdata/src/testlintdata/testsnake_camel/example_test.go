@@ -0,0 +1,13 @@
+package testlintdata
+
+import "testing"
+
+func TestCamelCaseStyle(t *testing.T) {
+	t.Run("addPositiveNumbers", func(t *testing.T) {
+		// Good: camelCase
+	})
+
+	t.Run("add_positive_numbers", func(t *testing.T) { // want "test name \"add_positive_numbers\" should use camelCase"
+		// Bad: this checker is configured for camelCase, so snake_case fails
+	})
+}
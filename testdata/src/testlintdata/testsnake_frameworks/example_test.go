@@ -0,0 +1,26 @@
+package testlintdata
+
+import "testing"
+
+// Config for this package sets Frameworks: []string{"ginkgo", "convey"}.
+
+// Describe, It, and Convey stand in for ginkgo's and Convey's package-level
+// registration functions: testsnake matches on the callee name alone, so a
+// same-shaped local function exercises the same code path without requiring
+// the real dependency in this testdata package.
+func Describe(name string, fn func()) { fn() }
+func It(name string, fn func())       { fn() }
+func Convey(name string, fn func())   { fn() }
+
+func TestFrameworkNames(t *testing.T) {
+	Describe("valid_snake_case", func() {
+		It("another_valid_case", func() {
+		})
+
+		It("InvalidCase", func() { // want "test name \"InvalidCase\" should use snake_case"
+		})
+	})
+
+	Convey("InvalidConveyCase", func() { // want "test name \"InvalidConveyCase\" should use snake_case"
+	})
+}
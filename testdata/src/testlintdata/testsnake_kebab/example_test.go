@@ -0,0 +1,13 @@
+package testlintdata
+
+import "testing"
+
+func TestKebabCaseStyle(t *testing.T) {
+	t.Run("add-positive-numbers", func(t *testing.T) {
+		// Good: kebab-case
+	})
+
+	t.Run("add_positive_numbers", func(t *testing.T) { // want "test name \"add_positive_numbers\" should use kebab-case"
+		// Bad: this checker is configured for kebab-case
+	})
+}
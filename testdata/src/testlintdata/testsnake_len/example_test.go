@@ -0,0 +1,19 @@
+package testlintdata
+
+import "testing"
+
+// Config for this package sets MinLen: 5, MaxLen: 20.
+
+func TestLengthBounds(t *testing.T) {
+	t.Run("ok_length", func(t *testing.T) {
+		// Good: within bounds
+	})
+
+	t.Run("ab", func(t *testing.T) { // want "test name \"ab\" is shorter than the minimum length 5"
+		// Bad: shorter than MinLen
+	})
+
+	t.Run("this_name_is_way_too_long_for_the_configured_bound", func(t *testing.T) { // want "test name \"this_name_is_way_too_long_for_the_configured_bound\" is longer than the maximum length 20"
+		// Bad: longer than MaxLen
+	})
+}
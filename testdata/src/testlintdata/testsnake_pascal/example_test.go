@@ -0,0 +1,13 @@
+package testlintdata
+
+import "testing"
+
+func TestPascalCaseStyle(t *testing.T) {
+	t.Run("AddPositiveNumbers", func(t *testing.T) {
+		// Good: PascalCase
+	})
+
+	t.Run("addPositiveNumbers", func(t *testing.T) { // want "test name \"addPositiveNumbers\" should use PascalCase"
+		// Bad: this checker is configured for PascalCase
+	})
+}
@@ -0,0 +1,15 @@
+package testlintdata
+
+import "testing"
+
+// Config for this package sets RequireSubtests: true.
+
+func TestWithSubtests(t *testing.T) {
+	t.Run("does_something", func(t *testing.T) {
+		// Good: declares a subtest
+	})
+}
+
+func TestWithoutSubtests(t *testing.T) { // want "test \"TestWithoutSubtests\" should call t.Run to declare subtests"
+	// Bad: never calls t.Run
+}
@@ -0,0 +1,25 @@
+package testlintdata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// Config for this package sets Style: PascalCase, Frameworks: []string{"testify"}.
+
+type ExampleSuite struct {
+	suite.Suite
+}
+
+func (s *ExampleSuite) TestAddsPositiveNumbers() {
+	// Good: "AddsPositiveNumbers" is valid PascalCase
+}
+
+func (s *ExampleSuite) TestAdds_NegativeNumbers() { // want "test name \"Adds_NegativeNumbers\" should use PascalCase"
+	// Bad: the underscore breaks PascalCase
+}
+
+func TestExampleSuite(t *testing.T) {
+	suite.Run(t, new(ExampleSuite))
+}
@@ -1,6 +1,7 @@
 package testsnake
 
 import (
+	"fmt"
 	"go/ast"
 	"go/constant"
 	"go/token"
@@ -10,99 +11,873 @@ import (
 	"unicode"
 
 	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
 )
 
 const (
 	name = "testsnake"
 	doc = "testsnake checks that test names in t.Run use snake_case convention"
-	msg = "test name %q should use snake_case (e.g., \"my_test_case\")"
+	msg = "test name %q should use %s (e.g., %q)"
+
+	msgTooShort        = "test name %q is shorter than the minimum length %d"
+	msgTooLong         = "test name %q is longer than the maximum length %d"
+	msgRequireSubtests = "test %q should call t.Run to declare subtests"
+)
+
+// Naming styles accepted by Config.Style.
+const (
+	styleSnakeCase  = "snake_case"
+	styleCamelCase  = "camelCase"
+	stylePascalCase = "PascalCase"
+	styleKebabCase  = "kebab-case"
+)
+
+// Testing frameworks accepted by Config.Frameworks, beyond the always-on
+// *testing.T/B/F support.
+const (
+	frameworkTestify = "testify"
+	frameworkGinkgo  = "ginkgo"
+	frameworkConvey  = "convey"
 )
 
-// Analyzer checks that test names in t.Run use snake_case
-var Analyzer = &analysis.Analyzer{
-	Name:             name,
-	Doc:              doc,
-	Run:              run,
-	RunDespiteErrors: true,
+// testifySuitePkgPath is the import path of testify's suite package, used to
+// recognize the package-qualified suite.Run(t, ts) call that kicks off a
+// testify test suite.
+const testifySuitePkgPath = "github.com/stretchr/testify/suite"
+
+// Config configures the testsnake analyzer. It is decoded from the
+// linter's settings via mapstructure, so zero values mean "use the default".
+type Config struct {
+	// Style is the required naming convention: snake_case, camelCase,
+	// PascalCase, or kebab-case. Defaults to snake_case.
+	Style string `mapstructure:"style"`
+	// Allow is a list of regexes; test names matching any of them are exempt.
+	Allow []string `mapstructure:"allow"`
+	// MinLen/MaxLen bound the test name length. Zero means unbounded.
+	MinLen int `mapstructure:"minLen"`
+	MaxLen int `mapstructure:"maxLen"`
+	// RequireSubtests flags top-level tests whose body never calls t.Run.
+	RequireSubtests bool `mapstructure:"requireSubtests"`
+	// Frameworks enables matching subtest registration beyond *testing.T/B/F,
+	// e.g. "ginkgo", "convey". "testify" additionally style-checks the
+	// TestXxx methods of suites passed to suite.Run(t, ts), since testify
+	// suites name their tests via exported Go methods rather than a string
+	// literal t.Run sees.
+	Frameworks []string `mapstructure:"frameworks"`
 }
 
-func run(pass *analysis.Pass) (interface{}, error) {
-	for _, file := range pass.Files {
-		// Only check test files
-		if !strings.HasSuffix(pass.Fset.File(file.Pos()).Name(), "_test.go") {
-			continue
+// defaultConfig returns the Config used when no settings are supplied.
+func defaultConfig() Config {
+	return Config{Style: styleSnakeCase}
+}
+
+// Analyzer checks that test names in t.Run use snake_case, using the
+// default Config. Use NewAnalyzer to customize its behavior.
+var Analyzer = NewAnalyzer(defaultConfig())
+
+// NewAnalyzer builds a testsnake Analyzer parameterized by cfg.
+func NewAnalyzer(cfg Config) *analysis.Analyzer {
+	return &analysis.Analyzer{
+		Name:     name,
+		Doc:      doc,
+		Requires: []*analysis.Analyzer{inspect.Analyzer},
+		Run: func(pass *analysis.Pass) (interface{}, error) {
+			return run(pass, cfg)
+		},
+		RunDespiteErrors: true,
+	}
+}
+
+func run(pass *analysis.Pass, cfg Config) (interface{}, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	tables := buildTableCache(pass, insp)
+	helperFields := make(map[*types.Func]subtestHelper)
+
+	insp.Preorder([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node) {
+		callExpr := n.(*ast.CallExpr)
+		if !isTestFilePos(pass, callExpr.Pos()) {
+			return
 		}
 
-		ast.Inspect(file, func(n ast.Node) bool {
-			callExpr, ok := n.(*ast.CallExpr)
-			if !ok {
-				return true
+		// A helper that itself ranges over a []T param and calls t.Run
+		// internally (the "subtests helper" pattern): validate the table
+		// passed at this call site using the field the helper keys off of.
+		if testCases, ok := subtestsPassedToHelper(pass, tables, helperFields, callExpr); ok {
+			for _, tc := range testCases {
+				checkTestName(pass, cfg, tc.value, tc.pos, tc.pos, tc.end)
 			}
+			return
+		}
 
-			// Check if this is a call to *.Run()
-			selExpr, ok := callExpr.Fun.(*ast.SelectorExpr)
-			if !ok {
-				return true
+		// Check if this is a subtest registration call (t.Run, and,
+		// depending on cfg.Frameworks, Describe/It/Convey)
+		if !isSubtestCall(pass, callExpr, cfg) {
+			return
+		}
+
+		// Check if there are at least 2 arguments (name and function)
+		if len(callExpr.Args) < 2 {
+			return
+		}
+
+		// Get the first argument (test name)
+		firstArg := callExpr.Args[0]
+
+		// Check if this is a selector expression (table-driven test)
+		if sel, ok := firstArg.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok {
+				// Look up the extracted test names from the table-driven range cache
+				testCases := extractValuesWithPosFromRange(pass, tables.rangeSlices, ident, sel.Sel.Name)
+				for _, tc := range testCases {
+					checkTestName(pass, cfg, tc.value, tc.pos, tc.pos, tc.end)
+				}
+				if len(testCases) > 0 {
+					return
+				}
 			}
+		}
 
-			// Check if the method name is "Run"
-			if selExpr.Sel.Name != "Run" {
-				return true
+		// Identifier resolved to a map range key variable (map-keyed table:
+		// the map key itself is the test name)
+		if ident, ok := firstArg.(*ast.Ident); ok {
+			if obj := pass.TypesInfo.ObjectOf(ident); obj != nil {
+				if mapLit, ok := tables.rangeMapKeys[obj]; ok {
+					for _, tc := range extractMapKeysWithPos(mapLit) {
+						checkTestName(pass, cfg, tc.value, tc.pos, tc.pos, tc.end)
+					}
+					return
+				}
 			}
+		}
 
-			// Check if the receiver is a testing type (testing.T, testing.B, testing.F)
-			if !isTestingType(pass, selExpr.X) {
-				return true
+		// Direct string literal: rewrite the literal itself
+		if lit, ok := firstArg.(*ast.BasicLit); ok {
+			testName := strings.Trim(lit.Value, "\"")
+			checkTestName(pass, cfg, testName, lit.Pos(), lit.Pos(), lit.End())
+			return
+		}
+
+		// Identifier resolved to a var assignment: report at the t.Run call
+		// site, but rewrite the RHS literal at the var's declaration.
+		if ident, ok := firstArg.(*ast.Ident); ok {
+			if testName, declPos, declEnd := findVarDecl(pass, ident); testName != "" {
+				checkTestName(pass, cfg, testName, ident.Pos(), declPos, declEnd)
+				return
 			}
+		}
 
-			// Check if there are at least 2 arguments (name and function)
-			if len(callExpr.Args) < 2 {
-				return true
+		// Fall back to a plain value (e.g. a package-level or cross-file constant,
+		// or a "a" + "b" concatenation) with no rewrite target
+		testName := strVal(pass, firstArg)
+		if testName == "" {
+			return
+		}
+
+		checkTestName(pass, cfg, testName, callExpr.Pos(), token.NoPos, token.NoPos)
+	})
+
+	if cfg.RequireSubtests {
+		for _, file := range pass.Files {
+			if strings.HasSuffix(pass.Fset.File(file.Pos()).Name(), "_test.go") {
+				checkRequireSubtests(pass, file)
 			}
+		}
+	}
 
-			// Get the first argument (test name)
-			firstArg := callExpr.Args[0]
-
-			// Check if this is a selector expression (table-driven test)
-			if sel, ok := firstArg.(*ast.SelectorExpr); ok {
-				if ident, ok := sel.X.(*ast.Ident); ok {
-					// Try to extract all test names from the table with their positions
-					testCases := extractValuesWithPosFromRange(pass, ident, sel.Sel.Name)
-					for _, tc := range testCases {
-						if tc.value != "" && !isValidSnakeCase(tc.value) {
-							pass.Reportf(tc.pos, msg, tc.value)
-						}
-					}
-					if len(testCases) > 0 {
-						return true
+	if hasFramework(cfg, frameworkTestify) {
+		for _, file := range pass.Files {
+			if strings.HasSuffix(pass.Fset.File(file.Pos()).Name(), "_test.go") {
+				checkTestifySuites(pass, cfg, file)
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// isTestFilePos reports whether pos falls within a _test.go file.
+func isTestFilePos(pass *analysis.Pass, pos token.Pos) bool {
+	f := pass.Fset.File(pos)
+	return f != nil && strings.HasSuffix(f.Name(), "_test.go")
+}
+
+// maxFuncResultDepth bounds how many calls deep resolveFuncResultLit will
+// follow when a table variable is assigned from a helper function's return
+// value, to avoid runaway recursion through mutually-referential helpers.
+const maxFuncResultDepth = 3
+
+// tableCache holds the package-wide lookups built by buildTableCache.
+type tableCache struct {
+	// decls maps a variable's object to the slice/array/map composite
+	// literal it was declared with, resolving one level of indirection
+	// through `tests := []T{...}` and, bounded, `tests := someHelper()`.
+	decls map[types.Object]*ast.CompositeLit
+	// rangeSlices maps a range statement's value variable (as in
+	// `for _, tt := range tests`) to the slice/array composite literal
+	// it ranges over.
+	rangeSlices map[types.Object]*ast.CompositeLit
+	// rangeMapKeys maps a range statement's key variable (as in
+	// `for name, tc := range tests`, tests being a map) to the map
+	// composite literal it ranges over.
+	rangeMapKeys map[types.Object]*ast.CompositeLit
+}
+
+// buildTableCache walks the package once and resolves every table-driven
+// test slice/map to its composite literal, keyed by the variable objects
+// that reference it. This lets extractValuesWithPosFromRange and its
+// siblings do an O(1) lookup per t.Run(tt.name, ...) call instead of
+// re-walking the file.
+func buildTableCache(pass *analysis.Pass, insp *inspector.Inspector) *tableCache {
+	decls := make(map[types.Object]*ast.CompositeLit)
+	var ranges []*ast.RangeStmt
+
+	insp.Preorder([]ast.Node{(*ast.AssignStmt)(nil), (*ast.RangeStmt)(nil)}, func(n ast.Node) {
+		switch stmt := n.(type) {
+		case *ast.AssignStmt:
+			for i, lhs := range stmt.Lhs {
+				ident, ok := lhs.(*ast.Ident)
+				if !ok || i >= len(stmt.Rhs) {
+					continue
+				}
+				obj := pass.TypesInfo.ObjectOf(ident)
+				if obj == nil {
+					continue
+				}
+
+				switch rhs := stmt.Rhs[i].(type) {
+				case *ast.CompositeLit:
+					decls[obj] = rhs
+				case *ast.CallExpr:
+					if comp := resolveFuncResultLit(pass, rhs, maxFuncResultDepth); comp != nil {
+						decls[obj] = comp
 					}
 				}
 			}
+		case *ast.RangeStmt:
+			ranges = append(ranges, stmt)
+		}
+	})
+
+	rangeSlices := make(map[types.Object]*ast.CompositeLit, len(ranges))
+	rangeMapKeys := make(map[types.Object]*ast.CompositeLit)
+	for _, stmt := range ranges {
+		lit := resolveCompositeLit(pass, decls, stmt.X)
+		if lit == nil {
+			continue
+		}
+
+		if valueIdent, ok := stmt.Value.(*ast.Ident); ok {
+			if valueObj := pass.TypesInfo.ObjectOf(valueIdent); valueObj != nil {
+				rangeSlices[valueObj] = lit
+			}
+		}
+
+		if _, isMap := lit.Type.(*ast.MapType); isMap {
+			if keyIdent, ok := stmt.Key.(*ast.Ident); ok && keyIdent.Name != "_" {
+				if keyObj := pass.TypesInfo.ObjectOf(keyIdent); keyObj != nil {
+					rangeMapKeys[keyObj] = lit
+				}
+			}
+		}
+	}
+
+	return &tableCache{decls: decls, rangeSlices: rangeSlices, rangeMapKeys: rangeMapKeys}
+}
+
+// resolveCompositeLit evaluates expr to the composite literal it denotes:
+// directly, or through a single `x := []T{...}` (or map) indirection
+// recorded in decls.
+func resolveCompositeLit(pass *analysis.Pass, decls map[types.Object]*ast.CompositeLit, expr ast.Expr) *ast.CompositeLit {
+	switch e := expr.(type) {
+	case *ast.CompositeLit:
+		return e
+	case *ast.Ident:
+		if obj := pass.TypesInfo.ObjectOf(e); obj != nil {
+			return decls[obj]
+		}
+	}
+	return nil
+}
+
+// resolveFuncResultLit follows a call to a same-package function named by a
+// plain identifier (e.g. `tests := cases()`) to the composite literal it returns,
+// descending through further such calls up to depth levels.
+func resolveFuncResultLit(pass *analysis.Pass, call *ast.CallExpr, depth int) *ast.CompositeLit {
+	if depth <= 0 {
+		return nil
+	}
+
+	ident, ok := call.Fun.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	fn, ok := pass.TypesInfo.ObjectOf(ident).(*types.Func)
+	if !ok {
+		return nil
+	}
+	decl := findFuncDecl(pass, fn)
+	if decl == nil || decl.Body == nil {
+		return nil
+	}
+
+	var result *ast.CompositeLit
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if result != nil {
+			return false
+		}
+		ret, ok := n.(*ast.ReturnStmt)
+		if !ok || len(ret.Results) != 1 {
+			return true
+		}
+		switch r := ret.Results[0].(type) {
+		case *ast.CompositeLit:
+			result = r
+		case *ast.CallExpr:
+			result = resolveFuncResultLit(pass, r, depth-1)
+		}
+		return true
+	})
+
+	return result
+}
+
+// findFuncDecl locates the *ast.FuncDecl in pass.Files that declares fn.
+func findFuncDecl(pass *analysis.Pass, fn *types.Func) *ast.FuncDecl {
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			if fd, ok := decl.(*ast.FuncDecl); ok && pass.TypesInfo.ObjectOf(fd.Name) == fn {
+				return fd
+			}
+		}
+	}
+	return nil
+}
+
+// compositeLitOf unwraps elt to the composite literal it holds: itself
+// directly, or the operand of a `&T{...}` pointer element.
+func compositeLitOf(elt ast.Expr) *ast.CompositeLit {
+	switch e := elt.(type) {
+	case *ast.CompositeLit:
+		return e
+	case *ast.UnaryExpr:
+		if e.Op == token.AND {
+			if comp, ok := e.X.(*ast.CompositeLit); ok {
+				return comp
+			}
+		}
+	}
+	return nil
+}
+
+// extractMapKeysWithPos extracts each string-literal key and its token range
+// from a map composite literal, e.g. map[string]T{"name_here": {...}}.
+func extractMapKeysWithPos(mapLit *ast.CompositeLit) []valueWithPos {
+	var values []valueWithPos
+	for _, elt := range mapLit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		lit, ok := kv.Key.(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			continue
+		}
+		values = append(values, valueWithPos{value: strings.Trim(lit.Value, "\""), pos: lit.Pos(), end: lit.End()})
+	}
+	return values
+}
+
+// subtestHelper describes how a local helper function keys its subtest
+// names off the elements of a slice parameter it ranges over internally.
+type subtestHelper struct {
+	sliceParamIndex int
+	// field is the struct field used as the test name (e.g. "name" in
+	// tc.name), or "" when the element itself is used directly.
+	field string
+	ok    bool
+}
+
+// subtestsPassedToHelper reports whether callExpr invokes a same-package
+// helper that internally ranges over a []T parameter and calls t.Run on
+// each element (the "subtests helper" pattern), and if so extracts the
+// would-be test names from the table passed at this call site.
+func subtestsPassedToHelper(pass *analysis.Pass, tables *tableCache, helperFields map[*types.Func]subtestHelper, callExpr *ast.CallExpr) ([]valueWithPos, bool) {
+	ident, ok := callExpr.Fun.(*ast.Ident)
+	if !ok {
+		return nil, false
+	}
+	fn, ok := pass.TypesInfo.ObjectOf(ident).(*types.Func)
+	if !ok {
+		return nil, false
+	}
+
+	helper, known := helperFields[fn]
+	if !known {
+		helper = findSubtestHelper(pass, findFuncDecl(pass, fn))
+		helperFields[fn] = helper
+	}
+	if !helper.ok || helper.sliceParamIndex >= len(callExpr.Args) {
+		return nil, false
+	}
+
+	lit := resolveCompositeLit(pass, tables.decls, callExpr.Args[helper.sliceParamIndex])
+	if lit == nil {
+		return nil, false
+	}
+
+	var values []valueWithPos
+	for _, elt := range lit.Elts {
+		if helper.field == "" {
+			if val, ok := eval(pass, elt); ok && val != "" {
+				values = append(values, valueWithPos{value: val, pos: elt.Pos(), end: elt.End()})
+			}
+			continue
+		}
+		comp := compositeLitOf(elt)
+		if comp == nil {
+			continue
+		}
+		val, pos, end := extractFieldValueWithPos(pass, comp, helper.field)
+		if val != "" {
+			values = append(values, valueWithPos{value: val, pos: pos, end: end})
+		}
+	}
+	return values, len(values) > 0
+}
 
-			// Try to get the string value (either from literal or constant variable)
-			testName := strVal(pass, firstArg)
-			if testName == "" {
+// findSubtestHelper inspects decl for the shape
+// `func helper(t *testing.T, cases []T) { for _, tc := range cases { t.Run(tc.field, ...) } }`
+// (or the field-less `t.Run(tc, ...)`/plain element form) and reports the
+// slice parameter's index and the field used for the test name.
+func findSubtestHelper(pass *analysis.Pass, decl *ast.FuncDecl) subtestHelper {
+	if decl == nil || decl.Body == nil || decl.Type.Params == nil {
+		return subtestHelper{}
+	}
+
+	sliceParamIndex := -1
+	var sliceParamObj types.Object
+	paramIndex := 0
+	for _, field := range decl.Type.Params.List {
+		if len(field.Names) != 1 {
+			paramIndex += len(field.Names)
+			continue
+		}
+		typ := pass.TypesInfo.TypeOf(field.Type)
+		if typ == nil {
+			paramIndex++
+			continue
+		}
+		if _, ok := typ.Underlying().(*types.Slice); ok {
+			sliceParamIndex = paramIndex
+			sliceParamObj = pass.TypesInfo.ObjectOf(field.Names[0])
+			break
+		}
+		paramIndex++
+	}
+	if sliceParamObj == nil {
+		return subtestHelper{}
+	}
+
+	result := subtestHelper{sliceParamIndex: sliceParamIndex}
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		if result.ok {
+			return false
+		}
+		rangeStmt, ok := n.(*ast.RangeStmt)
+		if !ok {
+			return true
+		}
+		rangeIdent, ok := rangeStmt.X.(*ast.Ident)
+		if !ok || pass.TypesInfo.ObjectOf(rangeIdent) != sliceParamObj {
+			return true
+		}
+		valueIdent, ok := rangeStmt.Value.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		valueObj := pass.TypesInfo.ObjectOf(valueIdent)
+
+		ast.Inspect(rangeStmt.Body, func(n2 ast.Node) bool {
+			if result.ok {
+				return false
+			}
+			call, ok := n2.(*ast.CallExpr)
+			if !ok || len(call.Args) == 0 {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || sel.Sel.Name != "Run" || !isTestingType(pass, sel.X) {
 				return true
 			}
 
-			// Check if the test name follows snake_case
-			if !isValidSnakeCase(testName) {
-				pass.Reportf(callExpr.Pos(), msg, testName)
+			switch arg := call.Args[0].(type) {
+			case *ast.SelectorExpr:
+				if fIdent, ok := arg.X.(*ast.Ident); ok && pass.TypesInfo.ObjectOf(fIdent) == valueObj {
+					result.field = arg.Sel.Name
+					result.ok = true
+				}
+			case *ast.Ident:
+				if pass.TypesInfo.ObjectOf(arg) == valueObj {
+					result.field = ""
+					result.ok = true
+				}
 			}
+			return true
+		})
+		return !result.ok
+	})
+
+	return result
+}
 
+// checkTestName validates name against cfg and reports a diagnostic at
+// reportPos, unless it is exempt via cfg.Allow, if it fails the configured
+// style or violates cfg.MinLen/cfg.MaxLen. editPos/editEnd are the token range to
+// rewrite in place, which may differ from reportPos (e.g. a var assigned
+// away from its t.Run call site); editEnd may be token.NoPos when no
+// rewrite target is available.
+func checkTestName(pass *analysis.Pass, cfg Config, name string, reportPos, editPos, editEnd token.Pos) {
+	if name == "" || isAllowed(cfg, name) {
+		return
+	}
+
+	if validate := validatorFor(cfg.Style); !validate(name) {
+		reportRename(pass, cfg.Style, name, converterFor(cfg.Style)(name), reportPos, editPos, editEnd)
+		return
+	}
+
+	if cfg.MinLen > 0 && len(name) < cfg.MinLen {
+		pass.Reportf(reportPos, msgTooShort, name, cfg.MinLen)
+		return
+	}
+	if cfg.MaxLen > 0 && len(name) > cfg.MaxLen {
+		pass.Reportf(reportPos, msgTooLong, name, cfg.MaxLen)
+	}
+}
+
+// isAllowed reports whether name matches one of cfg.Allow's regexes.
+func isAllowed(cfg Config, name string) bool {
+	for _, pattern := range cfg.Allow {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			continue
+		}
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkRequireSubtests reports top-level test functions in file whose body
+// never calls t.Run, when cfg.RequireSubtests is enabled.
+func checkRequireSubtests(pass *analysis.Pass, file *ast.File) {
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || fn.Body == nil {
+			continue
+		}
+		if !strings.HasPrefix(fn.Name.Name, "Test") {
+			continue
+		}
+		if fn.Type.Params == nil || len(fn.Type.Params.List) != 1 {
+			continue
+		}
+		param := fn.Type.Params.List[0]
+		if !isTestingType(pass, param.Type) || len(param.Names) != 1 {
+			continue
+		}
+		paramObj := pass.TypesInfo.ObjectOf(param.Names[0])
+
+		callsRun := false
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			callExpr, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			sel, ok := callExpr.Fun.(*ast.SelectorExpr)
+			if !ok || sel.Sel.Name != "Run" {
+				return true
+			}
+			if ident, ok := sel.X.(*ast.Ident); ok && pass.TypesInfo.ObjectOf(ident) == paramObj {
+				callsRun = true
+				return false
+			}
 			return true
 		})
+
+		if !callsRun {
+			pass.Reportf(fn.Name.Pos(), msgRequireSubtests, fn.Name.Name)
+		}
 	}
+}
 
-	return nil, nil
+// isSubtestCall reports whether callExpr registers a subtest: t.Run/b.Run/f.Run
+// always, and, depending on cfg.Frameworks, ginkgo's Describe/It or Convey's
+// Convey. testify's suite.Run(t, ts) is handled separately by
+// checkTestifySuites, since it names tests via Go methods rather than a
+// string literal argument.
+func isSubtestCall(pass *analysis.Pass, callExpr *ast.CallExpr, cfg Config) bool {
+	if sel, ok := callExpr.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Run" && isTestingType(pass, sel.X) {
+		return true
+	}
+
+	switch calleeName(callExpr.Fun) {
+	case "Describe", "It":
+		return hasFramework(cfg, frameworkGinkgo)
+	case "Convey":
+		return hasFramework(cfg, frameworkConvey)
+	}
+
+	return false
+}
+
+// calleeName returns the called function's name, whether it is a bare
+// identifier (possibly dot-imported) or a package/receiver selector.
+func calleeName(fun ast.Expr) string {
+	switch f := fun.(type) {
+	case *ast.Ident:
+		return f.Name
+	case *ast.SelectorExpr:
+		return f.Sel.Name
+	}
+	return ""
+}
+
+// hasFramework reports whether fw is enabled in cfg.Frameworks.
+func hasFramework(cfg Config, fw string) bool {
+	for _, f := range cfg.Frameworks {
+		if f == fw {
+			return true
+		}
+	}
+	return false
+}
+
+// checkTestifySuites finds testify's suite.Run(t, ts) calls in file and
+// style-checks each exported TestXxx method on ts's type: testify suites
+// name their tests via Go methods rather than a string literal, so the
+// configured style applies to the name after the "Test" prefix.
+func checkTestifySuites(pass *analysis.Pass, cfg Config, file *ast.File) {
+	ast.Inspect(file, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) != 2 || !isTestifySuiteRunCall(pass, call) {
+			return true
+		}
+
+		named := suiteNamedType(pass, call.Args[1])
+		if named == nil {
+			return true
+		}
+
+		for i := 0; i < named.NumMethods(); i++ {
+			method := named.Method(i)
+			subject := strings.TrimPrefix(method.Name(), "Test")
+			if subject == method.Name() || subject == "" {
+				continue
+			}
+			if decl := findFuncDecl(pass, method); decl != nil {
+				checkTestName(pass, cfg, subject, decl.Name.Pos(), token.NoPos, token.NoPos)
+			}
+		}
+		return true
+	})
+}
+
+// isTestifySuiteRunCall reports whether call is the package-qualified
+// suite.Run(t, ts) that hands a suite off to testify for execution, as
+// opposed to a same-named Run method on an unrelated receiver.
+func isTestifySuiteRunCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Run" {
+		return false
+	}
+	pkgIdent, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	pkgName, ok := pass.TypesInfo.ObjectOf(pkgIdent).(*types.PkgName)
+	if !ok {
+		return false
+	}
+	return pkgName.Imported().Path() == testifySuitePkgPath
+}
+
+// suiteNamedType resolves expr (the suite instance passed to suite.Run) to
+// its named type, unwrapping the pointer a suite is conventionally passed
+// as (e.g. new(MySuite) or &MySuite{}).
+func suiteNamedType(pass *analysis.Pass, expr ast.Expr) *types.Named {
+	typ := pass.TypesInfo.TypeOf(expr)
+	if typ == nil {
+		return nil
+	}
+	if ptr, ok := typ.(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	named, _ := typ.(*types.Named)
+	return named
 }
 
 type value interface{} // string | structConst
 
-// valueWithPos holds a string value and its position in the source
+// valueWithPos holds a string value and its token range in the source
 type valueWithPos struct {
 	value string
 	pos   token.Pos
+	end   token.Pos
+}
+
+// reportRename reports an invalid test name at reportPos and, when
+// editPos/editEnd identify a rewritable token range, attaches a
+// SuggestedFix that replaces that range with fixed. reportPos and the edit
+// range may point at different locations, e.g. a t.Run call site whose
+// name comes from a var declared elsewhere.
+func reportRename(pass *analysis.Pass, style, name, fixed string, reportPos, editPos, editEnd token.Pos) {
+	label, example := styleDescription(style)
+	if editPos == token.NoPos || editEnd == token.NoPos {
+		pass.Reportf(reportPos, msg, name, label, example)
+		return
+	}
+
+	pass.Report(analysis.Diagnostic{
+		Pos:     reportPos,
+		Message: fmt.Sprintf(msg, name, label, example),
+		SuggestedFixes: []analysis.SuggestedFix{
+			{
+				Message: fmt.Sprintf("rename to %q", fixed),
+				TextEdits: []analysis.TextEdit{
+					{
+						Pos:     editPos,
+						End:     editEnd,
+						NewText: []byte(fmt.Sprintf("%q", fixed)),
+					},
+				},
+			},
+		},
+	})
+}
+
+// validatorFor returns the name-validity check for the given Config.Style,
+// defaulting to snake_case for an empty or unrecognized style.
+func validatorFor(style string) func(string) bool {
+	switch style {
+	case styleCamelCase:
+		return isValidCamelCase
+	case stylePascalCase:
+		return isValidPascalCase
+	case styleKebabCase:
+		return isValidKebabCase
+	default:
+		return isValidSnakeCase
+	}
+}
+
+// converterFor returns the rewrite used in SuggestedFixes for the given
+// Config.Style, defaulting to snake_case for an empty or unrecognized style.
+func converterFor(style string) func(string) string {
+	switch style {
+	case styleCamelCase:
+		return toCamelCase
+	case stylePascalCase:
+		return toPascalCase
+	case styleKebabCase:
+		return toKebabCase
+	default:
+		return toSnakeCase
+	}
+}
+
+// styleDescription returns the human-readable style name and an example test
+// name in that style, for use in the rename diagnostic's message, defaulting
+// to snake_case for an empty or unrecognized style.
+func styleDescription(style string) (label, example string) {
+	switch style {
+	case styleCamelCase:
+		return styleCamelCase, "myTestCase"
+	case stylePascalCase:
+		return stylePascalCase, "MyTestCase"
+	case styleKebabCase:
+		return styleKebabCase, "my-test-case"
+	default:
+		return styleSnakeCase, "my_test_case"
+	}
+}
+
+// splitWords breaks name into its constituent words, splitting on "_", "-",
+// spaces, lower→upper transitions, and letter→digit transitions.
+func splitWords(name string) []string {
+	var words []string
+	var cur []rune
+	runes := []rune(name)
+
+	flush := func() {
+		if len(cur) > 0 {
+			words = append(words, string(cur))
+			cur = nil
+		}
+	}
+
+	for i, r := range runes {
+		if r == '_' || r == '-' || r == ' ' {
+			flush()
+			continue
+		}
+		if i > 0 {
+			prev := runes[i-1]
+			if prev != '_' && prev != '-' && prev != ' ' {
+				if (unicode.IsLower(prev) && unicode.IsUpper(r)) || (unicode.IsLetter(prev) && unicode.IsDigit(r)) {
+					flush()
+				}
+			}
+		}
+		cur = append(cur, r)
+	}
+	flush()
+
+	return words
+}
+
+// toSnakeCase converts name to snake_case.
+func toSnakeCase(name string) string {
+	words := splitWords(name)
+	for i, w := range words {
+		words[i] = strings.ToLower(w)
+	}
+	return strings.Join(words, "_")
+}
+
+// toKebabCase converts name to kebab-case.
+func toKebabCase(name string) string {
+	words := splitWords(name)
+	for i, w := range words {
+		words[i] = strings.ToLower(w)
+	}
+	return strings.Join(words, "-")
+}
+
+// toCamelCase converts name to camelCase.
+func toCamelCase(name string) string {
+	var b strings.Builder
+	for i, w := range splitWords(name) {
+		lower := strings.ToLower(w)
+		if i == 0 {
+			b.WriteString(lower)
+			continue
+		}
+		b.WriteString(strings.ToUpper(lower[:1]) + lower[1:])
+	}
+	return b.String()
+}
+
+// toPascalCase converts name to PascalCase.
+func toPascalCase(name string) string {
+	var b strings.Builder
+	for _, w := range splitWords(name) {
+		lower := strings.ToLower(w)
+		b.WriteString(strings.ToUpper(lower[:1]) + lower[1:])
+	}
+	return b.String()
 }
 
 // strVal extracts the string value from an expression
@@ -123,18 +898,15 @@ func fieldName(key ast.Expr) string {
 	return ""
 }
 
-// eval evaluates an expression and returns its value
+// eval evaluates expr to a string value. It folds through go/types'
+// constant evaluation, so it handles not just bare string literals and
+// `const` declarations but also concatenation ("a" + "b") and constants
+// defined in other files of the package, uniformly (the same approach go
+// vet's printf checker uses). A non-constant variable is resolved by
+// findVarDecl, which searches the package's files for its assignment.
 func eval(pass *analysis.Pass, expr ast.Expr) (string, bool) {
-	// string literal
-	if lit, ok := expr.(*ast.BasicLit); ok {
-		return strings.Trim(lit.Value, "\""), true
-	}
-
-	// constant value
-	if tv, ok := pass.TypesInfo.Types[expr]; ok && tv.Value != nil {
-		if tv.Value.Kind() == constant.String {
-			return strings.Trim(tv.Value.String(), "\""), true
-		}
+	if tv, ok := pass.TypesInfo.Types[expr]; ok && tv.Value != nil && tv.Value.Kind() == constant.String {
+		return constant.StringVal(tv.Value), true
 	}
 
 	// selector expression (e.g., tt.name in table-driven tests)
@@ -143,20 +915,12 @@ func eval(pass *analysis.Pass, expr ast.Expr) (string, bool) {
 		return "", false
 	}
 
-	// identifier
+	// identifier referring to a non-constant variable (e.g. `name := "..."`);
+	// constants are already covered above via pass.TypesInfo.Types
 	if ident, ok := expr.(*ast.Ident); ok {
 		if obj := pass.TypesInfo.ObjectOf(ident); obj != nil {
-			// Check if it's a constant
-			if konst, ok := obj.(*types.Const); ok {
-				if konst.Val().Kind() == constant.String {
-					return strings.Trim(konst.Val().String(), "\""), true
-				}
-			}
-
-			// Check if it's a variable
 			if _, ok := obj.(*types.Var); ok {
-				// Look for the variable's initialization
-				if decl := findVarDecl(pass, ident); decl != "" {
+				if decl, _, _ := findVarDecl(pass, ident); decl != "" {
 					return decl, true
 				}
 			}
@@ -166,118 +930,106 @@ func eval(pass *analysis.Pass, expr ast.Expr) (string, bool) {
 	return "", false
 }
 
-// extractFieldValueWithPos extracts a string field value and its position from a composite literal
-func extractFieldValueWithPos(pass *analysis.Pass, comp *ast.CompositeLit, targetField string) (string, token.Pos) {
+// extractFieldValueWithPos extracts a string field value and its token range
+// from a composite literal, whether the field is set by key (`name: "..."`)
+// or positionally, in which case targetField's index is resolved from the
+// struct's declared field order.
+func extractFieldValueWithPos(pass *analysis.Pass, comp *ast.CompositeLit, targetField string) (string, token.Pos, token.Pos) {
+	positional := true
 	for _, elt := range comp.Elts {
-		if kv, ok := elt.(*ast.KeyValueExpr); ok {
-			// Get the field name
-			key := fieldName(kv.Key)
-			if key == targetField {
-				// Recursively evaluate the value
-				if val, ok := eval(pass, kv.Value); ok {
-					return val, kv.Value.Pos()
-				}
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		positional = false
+		if fieldName(kv.Key) == targetField {
+			if val, ok := eval(pass, kv.Value); ok {
+				return val, kv.Value.Pos(), kv.Value.End()
 			}
 		}
 	}
-	return "", token.NoPos
-}
-
-// extractValuesWithPosFromRange extracts field values with positions from a slice used in a range statement
-func extractValuesWithPosFromRange(pass *analysis.Pass, rangeVar *ast.Ident, fieldName string) []valueWithPos {
-	obj := pass.TypesInfo.ObjectOf(rangeVar)
-	if obj == nil {
-		return nil
+	if !positional {
+		return "", token.NoPos, token.NoPos
 	}
 
-	var values []valueWithPos
-	var rangeExpr ast.Expr
-
-	// Find the range statement where this variable is defined
-	for _, file := range pass.Files {
-		ast.Inspect(file, func(n ast.Node) bool {
-			if rangeStmt, ok := n.(*ast.RangeStmt); ok {
-				// Check if this range statement defines our variable
-				if ident, ok := rangeStmt.Value.(*ast.Ident); ok {
-					if pass.TypesInfo.ObjectOf(ident) == obj {
-						rangeExpr = rangeStmt.X
-						return false // Found it, stop searching
-					}
-				}
-			}
-			return true
-		})
-		if rangeExpr != nil {
-			break
-		}
+	idx := structFieldIndex(pass, comp, targetField)
+	if idx < 0 || idx >= len(comp.Elts) {
+		return "", token.NoPos, token.NoPos
 	}
-
-	if rangeExpr == nil {
-		return nil
+	if val, ok := eval(pass, comp.Elts[idx]); ok {
+		return val, comp.Elts[idx].Pos(), comp.Elts[idx].End()
 	}
+	return "", token.NoPos, token.NoPos
+}
 
-	// Get the slice being ranged over
-	var sliceLit *ast.CompositeLit
-
-	// If rangeExpr is an identifier, find its declaration
-	if ident, ok := rangeExpr.(*ast.Ident); ok {
-		sliceObj := pass.TypesInfo.ObjectOf(ident)
-		if sliceObj == nil {
-			return nil
+// structFieldIndex returns targetField's position in comp's struct type,
+// or -1 if comp isn't a struct (or pointer to one) or has no such field.
+func structFieldIndex(pass *analysis.Pass, comp *ast.CompositeLit, targetField string) int {
+	typ := pass.TypesInfo.TypeOf(comp)
+	if typ == nil {
+		return -1
+	}
+	// An elided-`&` element of a []*T{{...}} literal carries type *T even
+	// though comp itself has no leading &, so unwrap the pointer the same
+	// way isTestingHandle does before asserting the struct type.
+	if ptr, ok := typ.Underlying().(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	st, ok := typ.Underlying().(*types.Struct)
+	if !ok {
+		return -1
+	}
+	for i := 0; i < st.NumFields(); i++ {
+		if st.Field(i).Name() == targetField {
+			return i
 		}
+	}
+	return -1
+}
 
-		// Find the slice declaration
-		for _, file := range pass.Files {
-			ast.Inspect(file, func(n ast.Node) bool {
-				if assign, ok := n.(*ast.AssignStmt); ok {
-					for i, lhs := range assign.Lhs {
-						if lhsIdent, ok := lhs.(*ast.Ident); ok {
-							if pass.TypesInfo.ObjectOf(lhsIdent) == sliceObj && i < len(assign.Rhs) {
-								if comp, ok := assign.Rhs[i].(*ast.CompositeLit); ok {
-									sliceLit = comp
-									return false
-								}
-							}
-						}
-					}
-				}
-				return true
-			})
-			if sliceLit != nil {
-				break
-			}
-		}
-	} else if comp, ok := rangeExpr.(*ast.CompositeLit); ok {
-		// Direct composite literal in range
-		sliceLit = comp
+// extractValuesWithPosFromRange looks up, via rangeSlices, the slice that
+// rangeVar was declared by a range statement over, and extracts fieldName's
+// value and token range from each element. Elements may be plain structs or
+// pointers to one (`&T{...}`).
+func extractValuesWithPosFromRange(pass *analysis.Pass, rangeSlices map[types.Object]*ast.CompositeLit, rangeVar *ast.Ident, fieldName string) []valueWithPos {
+	obj := pass.TypesInfo.ObjectOf(rangeVar)
+	if obj == nil {
+		return nil
 	}
 
-	if sliceLit == nil {
+	sliceLit, ok := rangeSlices[obj]
+	if !ok {
 		return nil
 	}
 
-	// Extract values with positions from each element in the slice
+	var values []valueWithPos
 	for _, elt := range sliceLit.Elts {
-		if comp, ok := elt.(*ast.CompositeLit); ok {
-			// Extract the field value and position from this struct
-			fieldVal, fieldPos := extractFieldValueWithPos(pass, comp, fieldName)
-			if fieldVal != "" {
-				values = append(values, valueWithPos{value: fieldVal, pos: fieldPos})
-			}
+		comp := compositeLitOf(elt)
+		if comp == nil {
+			continue
+		}
+		// Extract the field value and token range from this struct
+		fieldVal, fieldPos, fieldEnd := extractFieldValueWithPos(pass, comp, fieldName)
+		if fieldVal != "" {
+			values = append(values, valueWithPos{value: fieldVal, pos: fieldPos, end: fieldEnd})
 		}
 	}
 
 	return values
 }
 
-// findVarDecl tries to find the string literal value assigned to a variable
-func findVarDecl(pass *analysis.Pass, ident *ast.Ident) string {
+// findVarDecl tries to find the string value assigned to a variable -
+// whether a bare literal or any other expression go/constant can fold to a
+// string (e.g. "a" + "b" concatenation) - along with the token range of
+// that RHS expression so callers can rewrite it in place.
+func findVarDecl(pass *analysis.Pass, ident *ast.Ident) (string, token.Pos, token.Pos) {
 	obj := pass.TypesInfo.ObjectOf(ident)
 	if obj == nil {
-		return ""
+		return "", token.NoPos, token.NoPos
 	}
 
 	var result string
+	var pos, end token.Pos
 
 	// Find the declaration
 	for _, file := range pass.Files {
@@ -288,8 +1040,9 @@ func findVarDecl(pass *analysis.Pass, ident *ast.Ident) string {
 					if lhsIdent, ok := lhs.(*ast.Ident); ok {
 						if pass.TypesInfo.ObjectOf(lhsIdent) == obj && i < len(assign.Rhs) {
 							// Found the assignment
-							if lit, ok := assign.Rhs[i].(*ast.BasicLit); ok {
-								result = strings.Trim(lit.Value, "\"")
+							if val, ok := eval(pass, assign.Rhs[i]); ok {
+								result = val
+								pos, end = assign.Rhs[i].Pos(), assign.Rhs[i].End()
 								return false // Stop searching
 							}
 						}
@@ -303,22 +1056,117 @@ func findVarDecl(pass *analysis.Pass, ident *ast.Ident) string {
 		}
 	}
 
-	return result
+	return result, pos, end
 }
 
-// isTestingType checks if the expression is a testing type (testing.T, testing.B, testing.F)
+// maxEmbedDepth bounds how deep isTestingType descends into embedded fields
+// when looking for an embedded *testing.T/B/F, to avoid runaway recursion
+// through self-referential struct embeddings.
+const maxEmbedDepth = 3
+
+// isTestingType checks if expr's type is testing.T, testing.B, testing.F, or
+// testing.TB (by identity, not string comparison, so vendored copies of the
+// testing package, `type T = testing.T` aliases, and method values all
+// resolve correctly), or a type that embeds one of them.
 func isTestingType(pass *analysis.Pass, expr ast.Expr) bool {
 	typ := pass.TypesInfo.TypeOf(expr)
 	if typ == nil {
 		return false
 	}
+	return isTestingHandle(pass, typ, 0)
+}
+
+func isTestingHandle(pass *analysis.Pass, typ types.Type, depth int) bool {
+	if depth > maxEmbedDepth {
+		return false
+	}
 
-	// Get the string representation of the type
-	typeStr := typ.String()
-	types.Identical(typ, &types.Named{})
+	underlying := typ
+	if ptr, ok := typ.Underlying().(*types.Pointer); ok {
+		underlying = ptr.Elem()
+	}
+	for _, named := range testingNamedTypes(pass) {
+		if types.Identical(underlying, named) {
+			return true
+		}
+	}
 
-	// Check if it's a pointer to testing.T, testing.B, or testing.F
-	return typeStr == "*testing.T" || typeStr == "*testing.B" || typeStr == "*testing.F"
+	if tb := testingTBType(pass); tb != nil {
+		if iface, ok := tb.Underlying().(*types.Interface); ok {
+			if types.Implements(typ, iface) {
+				return true
+			}
+			if ptr, ok := typ.Underlying().(*types.Pointer); ok && types.Implements(ptr, iface) {
+				return true
+			}
+		}
+	}
+
+	// Embedding: a helper type embedding *testing.T/B/F, e.g.
+	// `type myT struct{ *testing.T }`, is still a testing type.
+	if st, ok := underlying.Underlying().(*types.Struct); ok {
+		for i := 0; i < st.NumFields(); i++ {
+			field := st.Field(i)
+			if field.Embedded() && isTestingHandle(pass, field.Type(), depth+1) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// testingNamedTypes resolves testing.T, testing.B, and testing.F from the
+// package's actual imports (so it works with vendored copies too), rather
+// than relying on the stringified type name.
+func testingNamedTypes(pass *analysis.Pass) []*types.Named {
+	pkg := importedPackage(pass, "testing")
+	if pkg == nil {
+		return nil
+	}
+
+	var named []*types.Named
+	for _, id := range []string{"T", "B", "F"} {
+		if n := namedTypeInPackage(pkg, id); n != nil {
+			named = append(named, n)
+		}
+	}
+	return named
+}
+
+// testingTBType resolves the testing.TB interface from the package's actual
+// imports, or nil if testing isn't imported.
+func testingTBType(pass *analysis.Pass) *types.Named {
+	pkg := importedPackage(pass, "testing")
+	if pkg == nil {
+		return nil
+	}
+	return namedTypeInPackage(pkg, "TB")
+}
+
+// importedPackage returns path's *types.Package among pass.Pkg's imports.
+func importedPackage(pass *analysis.Pass, path string) *types.Package {
+	for _, imp := range pass.Pkg.Imports() {
+		if imp.Path() == path {
+			return imp
+		}
+	}
+	return nil
+}
+
+// namedTypeInPackage looks up id in pkg's scope and returns its *types.Named,
+// or nil if id isn't a named type.
+func namedTypeInPackage(pkg *types.Package, id string) *types.Named {
+	obj := pkg.Scope().Lookup(id)
+	if obj == nil {
+		return nil
+	}
+	tn, ok := obj.(*types.TypeName)
+	if !ok {
+		return nil
+	}
+	named, _ := tn.Type().(*types.Named)
+	return named
 }
 
 // isValidSnakeCase checks if a string follows snake_case convention
@@ -345,3 +1193,30 @@ func isValidSnakeCase(name string) bool {
 	return snakeCasePattern.MatchString(name)
 }
 
+// isValidCamelCase checks if a string follows camelCase convention
+// Valid examples: myFunction, calculateSum, test123
+func isValidCamelCase(name string) bool {
+	if name == "" {
+		return false
+	}
+	return regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`).MatchString(name)
+}
+
+// isValidPascalCase checks if a string follows PascalCase convention
+// Valid examples: MyFunction, CalculateSum, Test123
+func isValidPascalCase(name string) bool {
+	if name == "" {
+		return false
+	}
+	return regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`).MatchString(name)
+}
+
+// isValidKebabCase checks if a string follows kebab-case convention
+// Valid examples: my-function, calculate-sum, test-123
+func isValidKebabCase(name string) bool {
+	if name == "" {
+		return false
+	}
+	return regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`).MatchString(name)
+}
+
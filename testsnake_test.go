@@ -0,0 +1,40 @@
+package testsnake
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+// TestAnalyzer runs the default-config Analyzer against the testdata
+// fixtures covering snake_case names, table-driven extraction, and aliased
+// and embedded *testing.T receivers.
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), Analyzer, "testlintdata/testsnake")
+}
+
+// TestAnalyzerConfig runs NewAnalyzer against one testdata package per
+// Config option, each configured to match what that package's fixtures
+// exercise.
+func TestAnalyzerConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		pkg  string
+		cfg  Config
+	}{
+		{"camel_case_style", "testlintdata/testsnake_camel", Config{Style: styleCamelCase}},
+		{"pascal_case_style", "testlintdata/testsnake_pascal", Config{Style: stylePascalCase}},
+		{"kebab_case_style", "testlintdata/testsnake_kebab", Config{Style: styleKebabCase}},
+		{"allowlist", "testlintdata/testsnake_allow", Config{Allow: []string{"^legacy_.*$"}}},
+		{"length_bounds", "testlintdata/testsnake_len", Config{MinLen: 5, MaxLen: 20}},
+		{"require_subtests", "testlintdata/testsnake_requiresubtests", Config{RequireSubtests: true}},
+		{"frameworks", "testlintdata/testsnake_frameworks", Config{Frameworks: []string{frameworkGinkgo, frameworkConvey}}},
+		{"testify_suite", "testlintdata/testsnake_testify", Config{Style: stylePascalCase, Frameworks: []string{frameworkTestify}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analysistest.Run(t, analysistest.TestData(), NewAnalyzer(tt.cfg), tt.pkg)
+		})
+	}
+}